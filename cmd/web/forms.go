@@ -0,0 +1,30 @@
+package main
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/go-playground/form/v4"
+)
+
+// decodePostForm parses r's POST body and decodes it into dst, which must
+// be a pointer to the destination struct.
+func (app *application) decodePostForm(r *http.Request, dst any) error {
+	err := r.ParseForm()
+	if err != nil {
+		return err
+	}
+
+	err = app.formDecoder.Decode(dst, r.PostForm)
+	if err != nil {
+		var invalidDecoderError *form.InvalidDecoderError
+
+		if errors.As(err, &invalidDecoderError) {
+			panic(err)
+		}
+
+		return err
+	}
+
+	return nil
+}
@@ -1,79 +1,108 @@
 package main
 
 import (
+	"errors"
 	"fmt"
-	"html/template"
-	"log"
 	"net/http"
 	"strconv"
+
+	"github.com/julienschmidt/httprouter"
+
+	"snippetbox.alexedwards.net/internal/models"
+	"snippetbox.alexedwards.net/internal/validator"
 )
 
-// Define a home handler function which writes a byte slice containing
-// "Welcome to the Snippetbox Home Page!" as the response body.
-func home(w http.ResponseWriter, r *http.Request) {
-	 // Check if the current request URL path exactly matches "/". If it doesn't, use
-    // the http.NotFound() function to send a 404 response to the client.
-    // Importantly, we then return from the handler. If we don't return the handler
-    // would keep executing and also write the "Hello from SnippetBox" message.
-    if r.URL.Path != "/" {
-        http.NotFound(w, r)
-        return
-    }
-
-    // Initialize a slice containing the paths to the two files. It's important
-    // to note that the file containing our base template must be the *first*
-    // file in the slice.
-    files := []string{
-        "./ui/html/base.tmpl",
-        "./ui/html/partials/nav.tmpl",
-        "./ui/html/pages/home.tmpl",
-    }
-
-    // Use the template.ParseFiles() function to read the template file into a
-    // template set. If there's an error, we log the detailed error message and use
-    // the http.Error() function to send a generic 500 Internal Server Error
-    // response to the user.
-    // ts, err := template.ParseFiles("./ui/html/pages/home.tmpl")
-    ts, err := template.ParseFiles(files...)
-    if err != nil {
-        log.Println(err.Error())
-        http.Error(w, "Internal Server Error", 500)
-        return
-    }
-
-    // We then use the Execute() method on the template set to write the
-    // template content as the response body. The last parameter to Execute()
-    // represents any dynamic data that we want to pass in, which for now we'll
-    // leave as nil.
-    // err = ts.Execute(w, nil)
-    err = ts.ExecuteTemplate(w, "base", nil)
-    if err != nil {
-        log.Println(err.Error())
-        http.Error(w, "Internal Server Error", 500)
-    }
-
-	// w.Write([]byte("Welcome to the Snippetbox Home Page!"))
+// home handles requests for the "/" route. It renders the home page with
+// the 10 most recently created snippets.
+func (app *application) home(w http.ResponseWriter, r *http.Request) {
+	snippets, err := app.snippets.Latest()
+	if err != nil {
+		app.serverError(w, r, err)
+		return
+	}
+
+	data := app.newTemplateData(r)
+	data.Snippets = snippets
+
+	app.render(w, r, http.StatusOK, "home.tmpl", data)
+}
+
+// snippetView handles requests for the "/snippet/view/:id" route.
+func (app *application) snippetView(w http.ResponseWriter, r *http.Request) {
+	params := httprouter.ParamsFromContext(r.Context())
+
+	id, err := strconv.Atoi(params.ByName("id"))
+	if err != nil || id < 1 {
+		app.notFound(w)
+		return
+	}
+
+	snippet, err := app.snippets.Get(id)
+	if err != nil {
+		if errors.Is(err, models.ErrNoRecord) {
+			app.notFound(w)
+		} else {
+			app.serverError(w, r, err)
+		}
+
+		return
+	}
+
+	data := app.newTemplateData(r)
+	data.Snippet = snippet
+
+	app.render(w, r, http.StatusOK, "view.tmpl", data)
 }
 
- // Add a snippetView handler function.
- func snippetView(w http.ResponseWriter, r *http.Request) {
-	id, err := strconv.Atoi(r.URL.Query().Get("id"))
-    if err != nil || id < 1 {
-        http.NotFound(w, r)
-        return
-    }
-
-    fmt.Fprintf(w, "Display a specific snippet with ID %d...", id)
- }
-
- // Add a snippetCreate handler function.
- func snippetCreate(w http.ResponseWriter, r *http.Request) {
-	// Use r.Method to check whether the request is using POST or not.
-    if r.Method != http.MethodPost {
-        w.Header().Set("Allow", http.MethodPost)
-		http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
-        return
-    }
-
-    w.Write([]byte("Create a new snippet..."))
- }
+// snippetCreateForm holds the fields of the snippet creation form, along
+// with an embedded Validator for tracking validation errors.
+type snippetCreateForm struct {
+	Title               string `form:"title"`
+	Content             string `form:"content"`
+	Expires             int    `form:"expires"`
+	validator.Validator `form:"-"`
+}
+
+// snippetCreate handles GET requests for the "/snippet/create" route. It
+// renders a blank form for creating a new snippet.
+func (app *application) snippetCreate(w http.ResponseWriter, r *http.Request) {
+	data := app.newTemplateData(r)
+	data.Form = snippetCreateForm{
+		Expires: 365,
+	}
+
+	app.render(w, r, http.StatusOK, "create.tmpl", data)
+}
+
+// snippetCreatePost handles POST requests for the "/snippet/create" route.
+// It validates the submitted form and, if valid, inserts a new snippet.
+func (app *application) snippetCreatePost(w http.ResponseWriter, r *http.Request) {
+	var form snippetCreateForm
+
+	err := app.decodePostForm(r, &form)
+	if err != nil {
+		app.clientError(w, http.StatusBadRequest)
+		return
+	}
+
+	form.CheckField(validator.NotBlank(form.Title), "title", "This field cannot be blank")
+	form.CheckField(validator.MaxChars(form.Title, 100), "title", "This field cannot be more than 100 characters long")
+	form.CheckField(validator.NotBlank(form.Content), "content", "This field cannot be blank")
+	form.CheckField(validator.PermittedInt(form.Expires, 1, 7, 365), "expires", "This field must equal 1, 7 or 365")
+
+	if !form.Valid() {
+		data := app.newTemplateData(r)
+		data.Form = form
+
+		app.render(w, r, http.StatusUnprocessableEntity, "create.tmpl", data)
+		return
+	}
+
+	id, err := app.snippets.Insert(form.Title, form.Content, form.Expires)
+	if err != nil {
+		app.serverError(w, r, err)
+		return
+	}
+
+	http.Redirect(w, r, fmt.Sprintf("/snippet/view/%d", id), http.StatusSeeOther)
+}
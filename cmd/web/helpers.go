@@ -0,0 +1,72 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"runtime/debug"
+	"time"
+)
+
+// serverError writes a log entry at Error level (including the request
+// method, URI and stack trace), then sends a generic 500 Internal Server
+// Error response to the user.
+func (app *application) serverError(w http.ResponseWriter, r *http.Request, err error) {
+	var (
+		method = r.Method
+		uri    = r.URL.RequestURI()
+		trace  = string(debug.Stack())
+	)
+
+	app.logger.Error(err.Error(), "method", method, "uri", uri, "trace", trace)
+
+	http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+}
+
+// clientError sends a specific status code and corresponding description
+// to the user, such as 400 "Bad Request" when there's a problem with the
+// request that the user sent.
+func (app *application) clientError(w http.ResponseWriter, status int) {
+	http.Error(w, http.StatusText(status), status)
+}
+
+// notFound is a convenience wrapper around clientError that sends a 404
+// Not Found response to the user.
+func (app *application) notFound(w http.ResponseWriter) {
+	app.clientError(w, http.StatusNotFound)
+}
+
+// render looks up the appropriate template set for a page from the
+// templateCache, executes it into an in-memory buffer, and only writes the
+// result to the ResponseWriter once we know it has executed without error.
+// This avoids sending a half-written page to the client if something goes
+// wrong partway through rendering.
+func (app *application) render(w http.ResponseWriter, r *http.Request, status int, page string, data *templateData) {
+	ts, ok := app.templateCache[page]
+	if !ok {
+		err := fmt.Errorf("the template %s does not exist", page)
+		app.serverError(w, r, err)
+		return
+	}
+
+	buf := new(bytes.Buffer)
+
+	err := ts.ExecuteTemplate(buf, "base", data)
+	if err != nil {
+		app.serverError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(status)
+
+	buf.WriteTo(w)
+}
+
+// newTemplateData returns a pointer to a templateData struct initialized
+// with the fields that should be present on every page, such as the
+// current year.
+func (app *application) newTemplateData(r *http.Request) *templateData {
+	return &templateData{
+		CurrentYear: time.Now().Year(),
+	}
+}
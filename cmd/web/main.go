@@ -0,0 +1,75 @@
+package main
+
+import (
+	"database/sql"
+	"flag"
+	"html/template"
+	"log/slog"
+	"net/http"
+	"os"
+
+	"github.com/go-playground/form/v4"
+	_ "github.com/go-sql-driver/mysql"
+
+	"snippetbox.alexedwards.net/internal/models"
+)
+
+// application holds the application-wide dependencies for the web application.
+// Any handler that needs access to one of these dependencies is defined as a
+// method against application.
+type application struct {
+	logger        *slog.Logger
+	templateCache map[string]*template.Template
+	formDecoder   *form.Decoder
+	snippets      *models.SnippetModel
+}
+
+func main() {
+	addr := flag.String("addr", ":4000", "HTTP network address")
+	dsn := flag.String("dsn", "web:pass@/snippetbox?parseTime=true", "MySQL data source name")
+	flag.Parse()
+
+	logger := slog.New(slog.NewJSONHandler(os.Stdout, nil))
+
+	db, err := openDB(*dsn)
+	if err != nil {
+		logger.Error(err.Error())
+		os.Exit(1)
+	}
+	defer db.Close()
+
+	templateCache, err := newTemplateCache()
+	if err != nil {
+		logger.Error(err.Error())
+		os.Exit(1)
+	}
+
+	app := &application{
+		logger:        logger,
+		templateCache: templateCache,
+		formDecoder:   form.NewDecoder(),
+		snippets:      &models.SnippetModel{DB: db},
+	}
+
+	logger.Info("starting server", "addr", *addr)
+
+	err = http.ListenAndServe(*addr, app.routes())
+	logger.Error(err.Error())
+	os.Exit(1)
+}
+
+// openDB wraps sql.Open and verifies that a connection can actually be
+// established before handing the pool back to the caller.
+func openDB(dsn string) (*sql.DB, error) {
+	db, err := sql.Open("mysql", dsn)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = db.Ping(); err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	return db, nil
+}
@@ -0,0 +1,40 @@
+package main
+
+import (
+	"net/http"
+	"path/filepath"
+)
+
+// neuteredFileSystem wraps an http.FileSystem and returns os.ErrNotExist for
+// any directory that doesn't contain an index.html file, so that bare
+// directory requests (e.g. "/static/" or "/static/css/") can't be used to
+// list the contents of that directory.
+type neuteredFileSystem struct {
+	fs http.FileSystem
+}
+
+func (nfs neuteredFileSystem) Open(path string) (http.File, error) {
+	f, err := nfs.fs.Open(path)
+	if err != nil {
+		return nil, err
+	}
+
+	stat, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	if stat.IsDir() {
+		index := filepath.Join(path, "index.html")
+		if _, err := nfs.fs.Open(index); err != nil {
+			closeErr := f.Close()
+			if closeErr != nil {
+				return nil, closeErr
+			}
+
+			return nil, err
+		}
+	}
+
+	return f, nil
+}
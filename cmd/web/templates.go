@@ -0,0 +1,79 @@
+package main
+
+import (
+	"html/template"
+	"io/fs"
+	"path/filepath"
+	"time"
+
+	"snippetbox.alexedwards.net/internal/sanitize"
+	"snippetbox.alexedwards.net/ui"
+)
+
+// templateData holds any dynamic data that we want to pass to our HTML
+// templates, so that every page can be rendered through the same set of
+// fields regardless of which handler populated them.
+type templateData struct {
+	CurrentYear int
+	Snippet     any
+	Snippets    any
+	Form        any
+	Flash       string
+}
+
+// humanDate returns a nicely formatted string representation of a time.Time
+// object, for use in the "humanDate" template function.
+func humanDate(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+
+	return t.Format("02 Jan 2006 at 15:04")
+}
+
+// safeHTML runs user-submitted content through the sanitize package's
+// strict allow-list policy and only then marks it as template.HTML.
+// Passing a string straight to template.HTML without going through this
+// is not safe - see the package doc comment on internal/sanitize for why.
+func safeHTML(s string) template.HTML {
+	return template.HTML(sanitize.HTML(s))
+}
+
+// functions is the template.FuncMap that we pass to templates when parsing
+// them, so that our custom functions are available for use.
+var functions = template.FuncMap{
+	"humanDate": humanDate,
+	"safeHTML":  safeHTML,
+}
+
+// newTemplateCache walks the embedded ui.Files filesystem for every page
+// under html/pages, parsing each one together with the base template and
+// all partials, and stores the resulting template set in a map keyed on
+// the page's file name.
+func newTemplateCache() (map[string]*template.Template, error) {
+	cache := map[string]*template.Template{}
+
+	pages, err := fs.Glob(ui.Files, "html/pages/*.tmpl")
+	if err != nil {
+		return nil, err
+	}
+
+	for _, page := range pages {
+		name := filepath.Base(page)
+
+		patterns := []string{
+			"html/base.tmpl",
+			"html/partials/*.tmpl",
+			page,
+		}
+
+		ts, err := template.New(name).Funcs(functions).ParseFS(ui.Files, patterns...)
+		if err != nil {
+			return nil, err
+		}
+
+		cache[name] = ts
+	}
+
+	return cache, nil
+}
@@ -0,0 +1,37 @@
+// Package sanitize strips user-submitted snippet content down to a small
+// allow-listed set of safe HTML tags before it is ever marked as trusted
+// via template.HTML. Passing raw user input to template.HTML directly
+// bypasses html/template's contextual auto-escaping entirely and opens
+// the door to stored XSS - it must always go through Policy first.
+//
+// This package only guards against malicious markup. It is not template
+// injection protection: html/template substitutes data into an
+// already-parsed template tree and never re-parses {{ }} sequences found
+// in that data, so literal braces in snippet content are inert and pass
+// through Policy unchanged.
+package sanitize
+
+import "github.com/microcosm-cc/bluemonday"
+
+// Policy is a strict bluemonday policy that permits only the minimal set
+// of formatting tags snippet content is allowed to use.
+var Policy = newPolicy()
+
+func newPolicy() *bluemonday.Policy {
+	p := bluemonday.NewPolicy()
+
+	p.AllowElements("p", "br", "strong", "em", "code", "pre")
+
+	p.AllowAttrs("href").OnElements("a")
+	p.RequireNoFollowOnLinks(true)
+	p.AllowURLSchemes("http", "https")
+
+	return p
+}
+
+// HTML runs s through Policy and returns the sanitized result. Callers
+// that need a template.HTML value should use the safeHTML template
+// function rather than calling this directly.
+func HTML(s string) string {
+	return Policy.Sanitize(s)
+}
@@ -0,0 +1,61 @@
+package sanitize
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestHTML(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{
+			name:  "script tag",
+			input: `<script>alert('xss')</script><p>hello</p>`,
+		},
+		{
+			name:  "javascript URL",
+			input: `<a href="javascript:alert('xss')">click me</a>`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := HTML(tt.input)
+
+			if strings.Contains(got, "<script") {
+				t.Errorf("HTML(%q) = %q, want script tag stripped", tt.input, got)
+			}
+
+			if strings.Contains(got, "javascript:") {
+				t.Errorf("HTML(%q) = %q, want javascript: URL stripped", tt.input, got)
+			}
+		})
+	}
+}
+
+// Literal {{ }} sequences in snippet content are not a template-injection
+// vector: html/template substitutes data into an already-parsed template
+// and never re-parses it, so Policy is not expected to touch them at all.
+// This test pins that expectation down rather than asserting nothing.
+func TestHTMLLeavesTemplateBracesInert(t *testing.T) {
+	input := `{{ .Secret }} <p>{{7*7}}</p>`
+
+	got := HTML(input)
+
+	want := `{{ .Secret }} <p>{{7*7}}</p>`
+	if got != want {
+		t.Errorf("HTML(%q) = %q, want %q", input, got, want)
+	}
+}
+
+func TestHTMLAllowsPermittedTags(t *testing.T) {
+	input := `<p>Some <strong>bold</strong> and <em>italic</em> text with <code>code</code>.</p>`
+
+	got := HTML(input)
+
+	if got != input {
+		t.Errorf("HTML(%q) = %q, want unchanged", input, got)
+	}
+}
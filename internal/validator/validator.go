@@ -0,0 +1,65 @@
+package validator
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// Validator is embedded in form structs to collect field-specific and
+// non-field-specific validation errors.
+type Validator struct {
+	NonFieldErrors []string
+	FieldErrors    map[string]string
+}
+
+// Valid returns true if FieldErrors and NonFieldErrors are both empty.
+func (v *Validator) Valid() bool {
+	return len(v.FieldErrors) == 0 && len(v.NonFieldErrors) == 0
+}
+
+// AddFieldError adds an error message for a given form field to the
+// FieldErrors map, as long as an entry doesn't already exist for that
+// field.
+func (v *Validator) AddFieldError(key, message string) {
+	if v.FieldErrors == nil {
+		v.FieldErrors = map[string]string{}
+	}
+
+	if _, exists := v.FieldErrors[key]; !exists {
+		v.FieldErrors[key] = message
+	}
+}
+
+// AddNonFieldError adds a message to the NonFieldErrors slice.
+func (v *Validator) AddNonFieldError(message string) {
+	v.NonFieldErrors = append(v.NonFieldErrors, message)
+}
+
+// CheckField adds an error message to the FieldErrors map only if a
+// validation check is not ok.
+func (v *Validator) CheckField(ok bool, key, message string) {
+	if !ok {
+		v.AddFieldError(key, message)
+	}
+}
+
+// NotBlank returns true if a value is not an empty string.
+func NotBlank(value string) bool {
+	return strings.TrimSpace(value) != ""
+}
+
+// MaxChars returns true if a value contains no more than n characters.
+func MaxChars(value string, n int) bool {
+	return utf8.RuneCountInString(value) <= n
+}
+
+// PermittedInt returns true if a value is in a list of permitted integers.
+func PermittedInt(value int, permittedValues ...int) bool {
+	for _, v := range permittedValues {
+		if value == v {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,73 @@
+package validator
+
+import "testing"
+
+func TestNotBlank(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  bool
+	}{
+		{name: "empty string", value: "", want: false},
+		{name: "whitespace only", value: "   ", want: false},
+		{name: "non-empty", value: "hello", want: true},
+		{name: "surrounded by whitespace", value: "  hello  ", want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NotBlank(tt.value)
+			if got != tt.want {
+				t.Errorf("NotBlank(%q) = %v, want %v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMaxChars(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		n     int
+		want  bool
+	}{
+		{name: "under limit", value: "hello", n: 10, want: true},
+		{name: "at limit", value: "hello", n: 5, want: true},
+		{name: "over limit", value: "hello", n: 4, want: false},
+		{name: "empty string", value: "", n: 0, want: true},
+		{name: "counts runes not bytes", value: "日本語", n: 3, want: true},
+		{name: "multi-byte runes over limit", value: "日本語", n: 2, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := MaxChars(tt.value, tt.n)
+			if got != tt.want {
+				t.Errorf("MaxChars(%q, %d) = %v, want %v", tt.value, tt.n, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPermittedInt(t *testing.T) {
+	tests := []struct {
+		name            string
+		value           int
+		permittedValues []int
+		want            bool
+	}{
+		{name: "permitted value", value: 7, permittedValues: []int{1, 7, 365}, want: true},
+		{name: "not permitted", value: 30, permittedValues: []int{1, 7, 365}, want: false},
+		{name: "empty permitted list", value: 1, permittedValues: []int{}, want: false},
+		{name: "boundary value matches", value: 365, permittedValues: []int{1, 7, 365}, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := PermittedInt(tt.value, tt.permittedValues...)
+			if got != tt.want {
+				t.Errorf("PermittedInt(%d, %v) = %v, want %v", tt.value, tt.permittedValues, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,11 @@
+package ui
+
+import "embed"
+
+// Files embeds the contents of the ui/html and ui/static directories into
+// the compiled binary, so the application no longer depends on these
+// assets being present on disk relative to the working directory at
+// runtime.
+//
+//go:embed "html" "static"
+var Files embed.FS